@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -18,6 +19,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// maxCorsAllowedOrigins is the maximum number of CORS allowed-origin entries an Azure Maps Account accepts.
+const maxCorsAllowedOrigins = 25
+
 func resourceMapsAccount() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMapsAccountCreateUpdate,
@@ -37,6 +41,33 @@ func resourceMapsAccount() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			if cmk, ok := d.GetOk("customer_managed_key"); ok && len(cmk.([]interface{})) > 0 {
+				if identity, ok := d.GetOk("identity"); !ok || len(identity.([]interface{})) == 0 {
+					return fmt.Errorf("`customer_managed_key` requires that an `identity` block is also specified")
+				}
+			}
+
+			if due, err := mapsAccountKeyRotationDue(d.Get("key_rotation").([]interface{}), time.Now().UTC()); err != nil {
+				return err
+			} else if due {
+				if err := d.SetNewComputed("key_rotation"); err != nil {
+					return fmt.Errorf("flagging `key_rotation` for update: %+v", err)
+				}
+			}
+
+			return nil
+		}),
+
+		SchemaVersion: 1,
+		StateUpgraders: []pluginsdk.StateUpgrader{
+			{
+				Type:    resourceMapsAccountV0Schema().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceMapsAccountStateUpgradeV0ToV1,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -58,6 +89,121 @@ func resourceMapsAccount() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(maps.ResourceIdentityTypeSystemAssigned),
+								string(maps.ResourceIdentityTypeUserAssigned),
+								string(maps.ResourceIdentityTypeSystemAssignedUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_origins": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: maxCorsAllowedOrigins,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"key_rotation": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"rotate_primary_key_after_days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"rotate_secondary_key_after_days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"primary_key_last_rotated_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"secondary_key_last_rotated_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.KeyVaultChildId,
+						},
+
+						"user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"x_ms_client_id": {
@@ -105,11 +251,22 @@ func resourceMapsAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	identity, err := expandMapsAccountIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	parameters := maps.Account{
 		Location: utils.String("global"),
 		Sku: &maps.Sku{
 			Name: maps.Name(sku),
 		},
+		Identity: identity,
+		Properties: &maps.AccountProperties{
+			DisableLocalAuth: utils.Bool(!d.Get("local_authentication_enabled").(bool)),
+			Encryption:       expandMapsAccountCustomerManagedKey(d.Get("customer_managed_key").([]interface{})),
+			Cors:             expandMapsAccountCors(d.Get("cors").([]interface{})),
+		},
 		Tags: tags.Expand(t),
 	}
 
@@ -128,6 +285,21 @@ func resourceMapsAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	d.SetId(*read.ID)
 
+	accountId, err := parse.AccountID(*read.ID)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("local_authentication_enabled").(bool) {
+		keyRotation := d.Get("key_rotation").([]interface{})
+		if err := rotateMapsAccountKeysIfDue(ctx, client, *accountId, keyRotation); err != nil {
+			return fmt.Errorf("rotating Access Keys on Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		if err := d.Set("key_rotation", keyRotation); err != nil {
+			return fmt.Errorf("setting `key_rotation`: %+v", err)
+		}
+	}
+
 	return resourceMapsAccountRead(d, meta)
 }
 
@@ -156,20 +328,137 @@ func resourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku_name", sku.Name)
 	}
+
+	if err := d.Set("identity", flattenMapsAccountIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	localAuthEnabled := true
 	if props := resp.Properties; props != nil {
 		d.Set("x_ms_client_id", props.UniqueID)
+
+		if props.DisableLocalAuth != nil {
+			localAuthEnabled = !*props.DisableLocalAuth
+		}
+
+		if err := d.Set("customer_managed_key", flattenMapsAccountCustomerManagedKey(props.Encryption)); err != nil {
+			return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+		}
+
+		if err := d.Set("cors", flattenMapsAccountCors(props.Cors)); err != nil {
+			return fmt.Errorf("setting `cors`: %+v", err)
+		}
 	}
+	d.Set("local_authentication_enabled", localAuthEnabled)
 
-	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("Error making Read Access Keys request on Maps Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if localAuthEnabled {
+		// NOTE: `key_rotation` is never mutated here - Read/Refresh must be side-effect-free.
+		// Whether a rotation is due is decided in CustomizeDiff and the actual
+		// `RegenerateKeys` call happens in resourceMapsAccountCreateUpdate.
+		keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error making Read Access Keys request on Maps Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		d.Set("primary_access_key", keysResp.PrimaryKey)
+		d.Set("secondary_access_key", keysResp.SecondaryKey)
+	} else {
+		d.Set("primary_access_key", "")
+		d.Set("secondary_access_key", "")
 	}
-	d.Set("primary_access_key", keysResp.PrimaryKey)
-	d.Set("secondary_access_key", keysResp.SecondaryKey)
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+// rotateMapsAccountKeysIfDue regenerates the primary and/or secondary access key when the
+// `key_rotation` block is configured and the previous rotation is older than the configured
+// threshold. Keys are regenerated one at a time so that downstream consumers pinned to a single
+// key are never without a valid key during the rotation.
+func rotateMapsAccountKeysIfDue(ctx context.Context, client *maps.AccountsClient, id parse.AccountId, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	now := time.Now().UTC()
+
+	if days := v["rotate_primary_key_after_days"].(int); days > 0 {
+		due, err := keyRotationDue(v["primary_key_last_rotated_time"].(string), days, now)
+		if err != nil {
+			return fmt.Errorf("parsing `key_rotation.0.primary_key_last_rotated_time`: %+v", err)
+		}
+
+		if due {
+			if _, err := client.RegenerateKeys(ctx, id.ResourceGroup, id.Name, maps.KeyTypePrimary); err != nil {
+				return fmt.Errorf("regenerating primary key: %+v", err)
+			}
+			v["primary_key_last_rotated_time"] = now.Format(time.RFC3339)
+		}
+	}
+
+	if days := v["rotate_secondary_key_after_days"].(int); days > 0 {
+		due, err := keyRotationDue(v["secondary_key_last_rotated_time"].(string), days, now)
+		if err != nil {
+			return fmt.Errorf("parsing `key_rotation.0.secondary_key_last_rotated_time`: %+v", err)
+		}
+
+		if due {
+			if _, err := client.RegenerateKeys(ctx, id.ResourceGroup, id.Name, maps.KeyTypeSecondary); err != nil {
+				return fmt.Errorf("regenerating secondary key: %+v", err)
+			}
+			v["secondary_key_last_rotated_time"] = now.Format(time.RFC3339)
+		}
+	}
+
+	return nil
+}
+
+func keyRotationDue(lastRotated string, afterDays int, now time.Time) (bool, error) {
+	if lastRotated == "" {
+		return true, nil
+	}
+
+	last, err := time.Parse(time.RFC3339, lastRotated)
+	if err != nil {
+		return false, err
+	}
+
+	return now.Sub(last) >= time.Duration(afterDays)*24*time.Hour, nil
+}
+
+// mapsAccountKeyRotationDue reports whether the configured `key_rotation` block has a primary or
+// secondary key whose rotation threshold has elapsed. It makes no API calls and is used from
+// CustomizeDiff to surface the pending rotation as a plan diff; the actual `RegenerateKeys` call
+// is made from resourceMapsAccountCreateUpdate once the diff has been applied.
+func mapsAccountKeyRotationDue(input []interface{}, now time.Time) (bool, error) {
+	if len(input) == 0 || input[0] == nil {
+		return false, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if days := v["rotate_primary_key_after_days"].(int); days > 0 {
+		due, err := keyRotationDue(v["primary_key_last_rotated_time"].(string), days, now)
+		if err != nil {
+			return false, fmt.Errorf("parsing `key_rotation.0.primary_key_last_rotated_time`: %+v", err)
+		}
+		if due {
+			return true, nil
+		}
+	}
+
+	if days := v["rotate_secondary_key_after_days"].(int); days > 0 {
+		due, err := keyRotationDue(v["secondary_key_last_rotated_time"].(string), days, now)
+		if err != nil {
+			return false, fmt.Errorf("parsing `key_rotation.0.secondary_key_last_rotated_time`: %+v", err)
+		}
+		if due {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func resourceMapsAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Maps.AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -186,3 +475,202 @@ func resourceMapsAccountDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func expandMapsAccountIdentity(input []interface{}) (*maps.Identity, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &maps.Identity{
+			Type: maps.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	identityType := maps.ResourceIdentityType(v["type"].(string))
+
+	identityIds := make(map[string]*maps.UserAssignedIdentity)
+	for _, raw := range v["identity_ids"].([]interface{}) {
+		identityIds[raw.(string)] = &maps.UserAssignedIdentity{}
+	}
+
+	if len(identityIds) > 0 && identityType != maps.ResourceIdentityTypeUserAssigned && identityType != maps.ResourceIdentityTypeSystemAssignedUserAssigned {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is `UserAssigned` or `SystemAssigned, UserAssigned`")
+	}
+
+	return &maps.Identity{
+		Type:                   identityType,
+		UserAssignedIdentities: identityIds,
+	}, nil
+}
+
+func flattenMapsAccountIdentity(input *maps.Identity) []interface{} {
+	if input == nil || input.Type == maps.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": identityIds,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandMapsAccountCustomerManagedKey(input []interface{}) *maps.Encryption {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	encryption := &maps.Encryption{
+		CustomerManagedKeyEncryption: &maps.CustomerManagedKeyEncryption{
+			KeyEncryptionKeyURL: utils.String(v["key_vault_key_id"].(string)),
+		},
+	}
+
+	if identityId := v["user_assigned_identity_id"].(string); identityId != "" {
+		encryption.CustomerManagedKeyEncryption.KeyEncryptionKeyIdentity = &maps.KeyEncryptionKeyIdentity{
+			IdentityType:                   maps.UserAssigned,
+			UserAssignedIdentityResourceID: utils.String(identityId),
+		}
+	}
+
+	return encryption
+}
+
+func flattenMapsAccountCustomerManagedKey(input *maps.Encryption) []interface{} {
+	if input == nil || input.CustomerManagedKeyEncryption == nil {
+		return []interface{}{}
+	}
+
+	cmk := input.CustomerManagedKeyEncryption
+
+	keyId := ""
+	if cmk.KeyEncryptionKeyURL != nil {
+		keyId = *cmk.KeyEncryptionKeyURL
+	}
+
+	identityId := ""
+	if cmk.KeyEncryptionKeyIdentity != nil && cmk.KeyEncryptionKeyIdentity.UserAssignedIdentityResourceID != nil {
+		identityId = *cmk.KeyEncryptionKeyIdentity.UserAssignedIdentityResourceID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          keyId,
+			"user_assigned_identity_id": identityId,
+		},
+	}
+}
+
+func expandMapsAccountCors(input []interface{}) *maps.CorsRules {
+	if len(input) == 0 || input[0] == nil {
+		return &maps.CorsRules{
+			CorsRules: &[]maps.CorsRule{},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	origins := make([]string, 0)
+	for _, origin := range v["allowed_origins"].([]interface{}) {
+		origins = append(origins, origin.(string))
+	}
+
+	return &maps.CorsRules{
+		CorsRules: &[]maps.CorsRule{
+			{
+				AllowedOrigins: &origins,
+			},
+		},
+	}
+}
+
+func flattenMapsAccountCors(input *maps.CorsRules) []interface{} {
+	if input == nil || input.CorsRules == nil || len(*input.CorsRules) == 0 {
+		return []interface{}{}
+	}
+
+	rule := (*input.CorsRules)[0]
+
+	origins := make([]interface{}, 0)
+	if rule.AllowedOrigins != nil {
+		for _, origin := range *rule.AllowedOrigins {
+			origins = append(origins, origin)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_origins": origins,
+		},
+	}
+}
+
+// resourceMapsAccountV0Schema is the schema as it existed prior to the addition of the
+// `identity` and `customer_managed_key` blocks, kept only so the state upgrader below can
+// describe the shape it's migrating from.
+func resourceMapsAccountV0Schema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"tags": tags.Schema(),
+
+			"x_ms_client_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceMapsAccountStateUpgradeV0ToV1(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState["identity"] == nil {
+		rawState["identity"] = []interface{}{}
+	}
+
+	if rawState["customer_managed_key"] == nil {
+		rawState["customer_managed_key"] = []interface{}{}
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,156 @@
+package maps
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/maps/mgmt/2021-02-01/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMapsCreator() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMapsCreatorCreateUpdate,
+		Read:   resourceMapsCreatorRead,
+		Update: resourceMapsCreatorCreateUpdate,
+		Delete: resourceMapsCreatorDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CreatorID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"maps_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			// Creator resources are regional, unlike the Maps Account they belong to.
+			"location": azure.SchemaLocation(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMapsCreatorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maps.CreatorsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Maps Creator creation.")
+
+	name := d.Get("name").(string)
+	accountId, err := parse.AccountID(d.Get("maps_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Maps Creator %q (Account %q / Resource Group %q): %+v", name, accountId.Name, accountId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_maps_creator", *existing.ID)
+		}
+	}
+
+	parameters := maps.Creator{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, accountId.ResourceGroup, accountId.Name, name, parameters); err != nil {
+		return fmt.Errorf("creating/updating Maps Creator %q (Account %q / Resource Group %q): %+v", name, accountId.Name, accountId.ResourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name, name)
+	if err != nil {
+		return fmt.Errorf("retrieving Maps Creator %q (Account %q / Resource Group %q): %+v", name, accountId.Name, accountId.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("cannot read Maps Creator %q (Account %q / Resource Group %q) ID", name, accountId.Name, accountId.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceMapsCreatorRead(d, meta)
+}
+
+func resourceMapsCreatorRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maps.CreatorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CreatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Maps Creator %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("making Read request on Maps Creator %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("maps_account_id", parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMapsCreatorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maps.CreatorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CreatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.AccountName, id.Name); err != nil {
+		return fmt.Errorf("deleting Maps Creator %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
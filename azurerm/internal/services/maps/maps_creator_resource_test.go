@@ -0,0 +1,148 @@
+package maps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MapsCreatorResource struct{}
+
+func TestAccMapsCreator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMapsCreator_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_maps_creator"),
+		},
+	})
+}
+
+func TestAccMapsCreator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MapsCreatorResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.CreatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Maps.CreatorsClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Maps Creator %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, id.ResourceGroup, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MapsCreatorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-maps-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "acctestmaps%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "S0"
+}
+
+resource "azurerm_maps_creator" "test" {
+  name            = "acctestmapscreator%d"
+  maps_account_id = azurerm_maps_account.test.id
+  location        = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r MapsCreatorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maps_creator" "import" {
+  name            = azurerm_maps_creator.test.name
+  maps_account_id = azurerm_maps_creator.test.maps_account_id
+  location        = azurerm_maps_creator.test.location
+}
+`, r.basic(data))
+}
+
+func (r MapsCreatorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-maps-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "acctestmaps%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "S0"
+}
+
+resource "azurerm_maps_creator" "test" {
+  name            = "acctestmapscreator%d"
+  maps_account_id = azurerm_maps_account.test.id
+  location        = azurerm_resource_group.test.location
+
+  tags = {
+    environment = "testing"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
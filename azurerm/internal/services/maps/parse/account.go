@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewAccountID(subscriptionId, resourceGroup, name string) AccountId {
+	return AccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id AccountId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Maps/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+func AccountID(input string) (*AccountId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := AccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if account.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
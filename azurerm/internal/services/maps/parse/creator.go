@@ -0,0 +1,54 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreatorId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+func NewCreatorID(subscriptionId, resourceGroup, accountName, name string) CreatorId {
+	return CreatorId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+func (id CreatorId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Maps/accounts/%s/creators/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+func CreatorID(input string) (*CreatorId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	creator := CreatorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if creator.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, err
+	}
+
+	if creator.Name, err = id.PopSegment("creators"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &creator, nil
+}
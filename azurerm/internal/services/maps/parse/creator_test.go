@@ -0,0 +1,80 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestCreatorIDFormatter(t *testing.T) {
+	actual := NewCreatorID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "creator1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1/creators/creator1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCreatorID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CreatorId
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1/creators/creator1",
+			Error: false,
+			Expected: &CreatorId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				AccountName:    "account1",
+				Name:           "creator1",
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q", test.Input)
+
+		actual, err := CreatorID(test.Input)
+		if test.Error {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one for %q", test.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %+v for %q", err, test.Input)
+		}
+
+		if actual.SubscriptionId != test.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for Subscription ID", test.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != test.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for Resource Group", test.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.AccountName != test.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for Account Name", test.Expected.AccountName, actual.AccountName)
+		}
+
+		if actual.Name != test.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", test.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package maps
+
+import "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Maps"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Maps",
+	}
+}
+
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_maps_account": resourceMapsAccount(),
+		"azurerm_maps_creator": resourceMapsCreator(),
+	}
+}
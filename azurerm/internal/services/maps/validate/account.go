@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func AccountName() func(interface{}, string) ([]string, []error) {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		// Portal: The name must begin and end with an alphanumeric character, may contain dashes and underscores, and must be between 1 and 98 characters.
+		if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,96}[a-zA-Z0-9]$|^[a-zA-Z0-9]$`).MatchString(v) {
+			errors = append(errors, fmt.Errorf("%q must begin and end with an alphanumeric character, may contain dashes and underscores, and must be between 1 and 98 characters", k))
+		}
+
+		return
+	}
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/parse"
+)
+
+func CreatorID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", key))
+		return
+	}
+
+	if _, err := parse.CreatorID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// KeyVaultChildId validates that the supplied string is a versioned Key Vault key ID,
+// e.g. https://example-vault.vault.azure.net/keys/example-key/00000000000000000000000000000000
+func KeyVaultChildId(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^https://.+\.vault(?:-int)?\.azure\.net/keys/[^/]+/[a-f0-9]{32}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q is not a valid versioned Key Vault Key ID: %q", k, v))
+	}
+
+	return
+}